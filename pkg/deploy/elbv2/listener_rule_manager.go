@@ -73,6 +73,10 @@ func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2mod
 		}
 		return nil
 	}); err != nil {
+		if isTooManyListenerRulesError(err) {
+			return elbv2model.ListenerRuleStatus{}, errors.Wrapf(err, "failed to create listener rule for %v with priority %v, listener %v has reached the maximum number of rules",
+				resLR.Stack().StackID(), resLR.Spec.Priority, awssdk.StringValue(req.ListenerArn))
+		}
 		return elbv2model.ListenerRuleStatus{}, errors.Wrap(err, "failed to create listener rule")
 	}
 	m.logger.Info("created listener rule",
@@ -100,6 +104,11 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 	m.logger.Info("deleting listener rule",
 		"arn", awssdk.StringValue(req.RuleArn))
 	if _, err := m.elbv2Client.DeleteRuleWithContext(ctx, req); err != nil {
+		if isListenerRuleNotFoundError(err) {
+			m.logger.Info("listener rule already deleted",
+				"arn", awssdk.StringValue(req.RuleArn))
+			return nil
+		}
 		return err
 	}
 	m.logger.Info("deleted listener rule",
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 (interfaces: ListenerRuleManager)
+
+// Package elbv2 is a generated GoMock package.
+package elbv2
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	elbv20 "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// MockListenerRuleManager is a mock of ListenerRuleManager interface.
+type MockListenerRuleManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockListenerRuleManagerMockRecorder
+}
+
+// MockListenerRuleManagerMockRecorder is the mock recorder for MockListenerRuleManager.
+type MockListenerRuleManagerMockRecorder struct {
+	mock *MockListenerRuleManager
+}
+
+// NewMockListenerRuleManager creates a new mock instance.
+func NewMockListenerRuleManager(ctrl *gomock.Controller) *MockListenerRuleManager {
+	mock := &MockListenerRuleManager{ctrl: ctrl}
+	mock.recorder = &MockListenerRuleManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListenerRuleManager) EXPECT() *MockListenerRuleManagerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockListenerRuleManager) Create(arg0 context.Context, arg1 *elbv20.ListenerRule) (elbv20.ListenerRuleStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(elbv20.ListenerRuleStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockListenerRuleManagerMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockListenerRuleManager)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockListenerRuleManager) Delete(arg0 context.Context, arg1 ListenerRuleWithTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockListenerRuleManagerMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockListenerRuleManager)(nil).Delete), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockListenerRuleManager) Update(arg0 context.Context, arg1 *elbv20.ListenerRule, arg2 ListenerRuleWithTags) (elbv20.ListenerRuleStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(elbv20.ListenerRuleStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockListenerRuleManagerMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockListenerRuleManager)(nil).Update), arg0, arg1, arg2)
+}
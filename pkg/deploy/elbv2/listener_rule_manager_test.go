@@ -0,0 +1,365 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+	"time"
+)
+
+func Test_defaultListenerRuleManager_updateSDKListenerRuleWithSettings(t *testing.T) {
+	type modifyRuleWithContextCall struct {
+		req  *elbv2sdk.ModifyRuleInput
+		resp *elbv2sdk.ModifyRuleOutput
+		err  error
+	}
+	type fields struct {
+		modifyRuleWithContextCalls []modifyRuleWithContextCall
+	}
+	type args struct {
+		lrSpec elbv2model.ListenerRuleSpec
+		sdkLR  ListenerRuleWithTags
+	}
+	fixedResponseAction := elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			StatusCode: "404",
+		},
+	}
+	sdkFixedResponseAction := &elbv2sdk.Action{
+		Type:  awssdk.String("fixed-response"),
+		Order: awssdk.Int64(1),
+		FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+			StatusCode: awssdk.String("404"),
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "host-header condition dropped one value",
+			fields: fields{
+				modifyRuleWithContextCalls: []modifyRuleWithContextCall{
+					{
+						req: &elbv2sdk.ModifyRuleInput{
+							RuleArn: awssdk.String("my-rule"),
+							Actions: []*elbv2sdk.Action{sdkFixedResponseAction},
+							Conditions: []*elbv2sdk.RuleCondition{
+								{
+									Field: awssdk.String("host-header"),
+									HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+										Values: awssdk.StringSlice([]string{"a.com"}),
+									},
+								},
+							},
+						},
+						resp: &elbv2sdk.ModifyRuleOutput{},
+					},
+				},
+			},
+			args: args{
+				lrSpec: elbv2model.ListenerRuleSpec{
+					ListenerARN: coremodel.LiteralStringToken("my-listener"),
+					Priority:    1,
+					Actions:     []elbv2model.Action{fixedResponseAction},
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldHostHeader,
+							HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+								Values: []string{"a.com"},
+							},
+						},
+					},
+				},
+				sdkLR: ListenerRuleWithTags{
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn:  awssdk.String("my-rule"),
+						Priority: awssdk.String("1"),
+						Actions:  []*elbv2sdk.Action{sdkFixedResponseAction},
+						Conditions: []*elbv2sdk.RuleCondition{
+							{
+								Field:  awssdk.String("host-header"),
+								Values: awssdk.StringSlice([]string{"a.com", "b.com"}),
+								HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+									Values: awssdk.StringSlice([]string{"a.com", "b.com"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "host-header condition values in different order",
+			args: args{
+				lrSpec: elbv2model.ListenerRuleSpec{
+					ListenerARN: coremodel.LiteralStringToken("my-listener"),
+					Priority:    1,
+					Actions:     []elbv2model.Action{fixedResponseAction},
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldHostHeader,
+							HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+								Values: []string{"b.com", "a.com"},
+							},
+						},
+					},
+				},
+				sdkLR: ListenerRuleWithTags{
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn:  awssdk.String("my-rule"),
+						Priority: awssdk.String("1"),
+						Actions:  []*elbv2sdk.Action{sdkFixedResponseAction},
+						Conditions: []*elbv2sdk.RuleCondition{
+							{
+								Field:  awssdk.String("host-header"),
+								Values: awssdk.StringSlice([]string{"a.com", "b.com"}),
+								HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+									Values: awssdk.StringSlice([]string{"a.com", "b.com"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.modifyRuleWithContextCalls {
+				elbv2Client.EXPECT().ModifyRuleWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultListenerRuleManager{
+				elbv2Client: elbv2Client,
+				logger:      &log.NullLogger{},
+			}
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			resLR := elbv2model.NewListenerRule(stack, "80:1", tt.args.lrSpec)
+			err := m.updateSDKListenerRuleWithSettings(context.Background(), resLR, tt.args.sdkLR)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultListenerRuleManager_Create(t *testing.T) {
+	type createRuleWithContextCall struct {
+		resp *elbv2sdk.CreateRuleOutput
+		err  error
+	}
+	type fields struct {
+		createRuleWithContextCalls []createRuleWithContextCall
+	}
+	type args struct {
+		priority int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    elbv2model.ListenerRuleStatus
+		wantErr error
+	}{
+		{
+			name: "listener rule created",
+			fields: fields{
+				createRuleWithContextCalls: []createRuleWithContextCall{
+					{
+						resp: &elbv2sdk.CreateRuleOutput{
+							Rules: []*elbv2sdk.Rule{
+								{
+									RuleArn:  awssdk.String("my-rule"),
+									Priority: awssdk.String("1"),
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				priority: 1,
+			},
+			want: elbv2model.ListenerRuleStatus{
+				RuleARN: "my-rule",
+			},
+		},
+		{
+			name: "listener has reached the maximum number of rules",
+			fields: fields{
+				createRuleWithContextCalls: []createRuleWithContextCall{
+					{
+						err: awserr.New("TooManyRules", "some message", nil),
+					},
+				},
+			},
+			args: args{
+				priority: 101,
+			},
+			wantErr: errors.New("failed to create listener rule for namespace/name with priority 101, listener my-listener has reached the maximum number of rules: TooManyRules: some message"),
+		},
+		{
+			name: "listener rule failed to create",
+			fields: fields{
+				createRuleWithContextCalls: []createRuleWithContextCall{
+					{
+						err: awserr.New("PriorityInUse", "some message", nil),
+					},
+				},
+			},
+			args: args{
+				priority: 1,
+			},
+			wantErr: errors.New("failed to create listener rule: PriorityInUse: some message"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.createRuleWithContextCalls {
+				elbv2Client.EXPECT().CreateRuleWithContext(gomock.Any(), gomock.Any()).Return(call.resp, call.err)
+			}
+			m := &defaultListenerRuleManager{
+				elbv2Client:                 elbv2Client,
+				trackingProvider:            tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				logger:                      &log.NullLogger{},
+				waitLSExistencePollInterval: 10 * time.Millisecond,
+				waitLSExistenceTimeout:      50 * time.Millisecond,
+			}
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			resLR := elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+				ListenerARN: coremodel.LiteralStringToken("my-listener"),
+				Priority:    tt.args.priority,
+			})
+			got, err := m.Create(context.Background(), resLR)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultListenerRuleManager_Delete(t *testing.T) {
+	type deleteRuleWithContextCall struct {
+		req  *elbv2sdk.DeleteRuleInput
+		resp *elbv2sdk.DeleteRuleOutput
+		err  error
+	}
+	type fields struct {
+		deleteRuleWithContextCalls []deleteRuleWithContextCall
+	}
+	type args struct {
+		sdkLR ListenerRuleWithTags
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "listener rule deleted",
+			fields: fields{
+				deleteRuleWithContextCalls: []deleteRuleWithContextCall{
+					{
+						req: &elbv2sdk.DeleteRuleInput{
+							RuleArn: awssdk.String("my-rule"),
+						},
+						resp: &elbv2sdk.DeleteRuleOutput{},
+					},
+				},
+			},
+			args: args{
+				sdkLR: ListenerRuleWithTags{
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn: awssdk.String("my-rule"),
+					},
+				},
+			},
+		},
+		{
+			name: "listener rule already deleted",
+			fields: fields{
+				deleteRuleWithContextCalls: []deleteRuleWithContextCall{
+					{
+						req: &elbv2sdk.DeleteRuleInput{
+							RuleArn: awssdk.String("my-rule"),
+						},
+						err: awserr.New("RuleNotFound", "some message", nil),
+					},
+				},
+			},
+			args: args{
+				sdkLR: ListenerRuleWithTags{
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn: awssdk.String("my-rule"),
+					},
+				},
+			},
+		},
+		{
+			name: "listener rule failed to delete",
+			fields: fields{
+				deleteRuleWithContextCalls: []deleteRuleWithContextCall{
+					{
+						req: &elbv2sdk.DeleteRuleInput{
+							RuleArn: awssdk.String("my-rule"),
+						},
+						err: awserr.New("OperationNotPermitted", "some message", nil),
+					},
+				},
+			},
+			args: args{
+				sdkLR: ListenerRuleWithTags{
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn: awssdk.String("my-rule"),
+					},
+				},
+			},
+			wantErr: errors.New("OperationNotPermitted: some message"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.deleteRuleWithContextCalls {
+				elbv2Client.EXPECT().DeleteRuleWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultListenerRuleManager{
+				elbv2Client: elbv2Client,
+				logger:      &log.NullLogger{},
+			}
+			err := m.Delete(context.Background(), tt.args.sdkLR)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
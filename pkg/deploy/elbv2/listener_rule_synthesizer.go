@@ -4,6 +4,7 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -66,16 +67,28 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		return err
 	}
 
-	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs, err := matchResAndSDKListenerRules(resLRs, sdkLRs)
+	if err != nil {
+		return err
+	}
 	for _, sdkLR := range unmatchedSDKLRs {
 		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
 			return err
 		}
 	}
-	for _, resLR := range unmatchedResLRs {
+	var tooManyRulesErr error
+	for idx, resLR := range unmatchedResLRs {
 		lrStatus, err := s.lrManager.Create(ctx, resLR)
 		if err != nil {
-			return err
+			if !isTooManyListenerRulesError(err) {
+				return err
+			}
+			// the listener is full, creating remaining rules would fail with the same error.
+			// we still update existing rules, and report the error once after that.
+			existingRulesCount := len(sdkLRs) - len(unmatchedSDKLRs) + idx
+			tooManyRulesErr = errors.Wrapf(err, "listener rule count: %v, listener rules not created: %v",
+				existingRulesCount, len(unmatchedResLRs)-idx)
+			break
 		}
 		resLR.SetStatus(lrStatus)
 	}
@@ -86,7 +99,7 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
-	return nil
+	return tooManyRulesErr
 }
 
 // findSDKListenersRulesOnLS returns the listenerRules configured on Listener.
@@ -110,13 +123,16 @@ type resAndSDKListenerRulePair struct {
 	sdkLR ListenerRuleWithTags
 }
 
-func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
+func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags, error) {
 	var matchedResAndSDKLRs []resAndSDKListenerRulePair
 	var unmatchedResLRs []*elbv2model.ListenerRule
 	var unmatchedSDKLRs []ListenerRuleWithTags
 
 	resLRByPriority := mapResListenerRuleByPriority(resLRs)
-	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRs)
+	sdkLRByPriority, err := mapSDKListenerRuleByPriority(sdkLRs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	resLRPriorities := sets.Int64KeySet(resLRByPriority)
 	sdkLRPriorities := sets.Int64KeySet(sdkLRByPriority)
 	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
@@ -134,7 +150,7 @@ func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []Lis
 		unmatchedSDKLRs = append(unmatchedSDKLRs, sdkLRByPriority[priority])
 	}
 
-	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs
+	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs, nil
 }
 
 func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*elbv2model.ListenerRule {
@@ -145,13 +161,16 @@ func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*
 	return resLRByPriority
 }
 
-func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
+func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) (map[int64]ListenerRuleWithTags, error) {
 	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
 	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+		priority, err := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected listener rule with invalid priority: %v", awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
+		}
 		sdkLRByPriority[priority] = sdkLR
 	}
-	return sdkLRByPriority
+	return sdkLRByPriority, nil
 }
 
 func mapResListenerRuleByListenerARN(resLRs []*elbv2model.ListenerRule) (map[string][]*elbv2model.ListenerRule, error) {
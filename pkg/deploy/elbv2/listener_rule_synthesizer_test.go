@@ -0,0 +1,251 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"testing"
+)
+
+func Test_mapSDKListenerRuleByPriority(t *testing.T) {
+	type args struct {
+		sdkLRs []ListenerRuleWithTags
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[int64]ListenerRuleWithTags
+		wantErr error
+	}{
+		{
+			name: "numeric priorities",
+			args: args{
+				sdkLRs: []ListenerRuleWithTags{
+					{
+						ListenerRule: &elbv2sdk.Rule{
+							RuleArn:  awssdk.String("arn-1"),
+							Priority: awssdk.String("1"),
+						},
+					},
+					{
+						ListenerRule: &elbv2sdk.Rule{
+							RuleArn:  awssdk.String("arn-2"),
+							Priority: awssdk.String("2"),
+						},
+					},
+				},
+			},
+			want: map[int64]ListenerRuleWithTags{
+				1: {
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn:  awssdk.String("arn-1"),
+						Priority: awssdk.String("1"),
+					},
+				},
+				2: {
+					ListenerRule: &elbv2sdk.Rule{
+						RuleArn:  awssdk.String("arn-2"),
+						Priority: awssdk.String("2"),
+					},
+				},
+			},
+		},
+		{
+			name: "no listener rules",
+			args: args{
+				sdkLRs: nil,
+			},
+			want: map[int64]ListenerRuleWithTags{},
+		},
+		{
+			name: "default priority",
+			args: args{
+				sdkLRs: []ListenerRuleWithTags{
+					{
+						ListenerRule: &elbv2sdk.Rule{
+							RuleArn:  awssdk.String("arn-1"),
+							Priority: awssdk.String("default"),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("unexpected listener rule with invalid priority: arn-1: strconv.ParseInt: parsing \"default\": invalid syntax"),
+		},
+		{
+			name: "empty priority",
+			args: args{
+				sdkLRs: []ListenerRuleWithTags{
+					{
+						ListenerRule: &elbv2sdk.Rule{
+							RuleArn: awssdk.String("arn-1"),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("unexpected listener rule with invalid priority: arn-1: strconv.ParseInt: parsing \"\": invalid syntax"),
+		},
+		{
+			name: "garbage priority",
+			args: args{
+				sdkLRs: []ListenerRuleWithTags{
+					{
+						ListenerRule: &elbv2sdk.Rule{
+							RuleArn:  awssdk.String("arn-1"),
+							Priority: awssdk.String("1a"),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("unexpected listener rule with invalid priority: arn-1: strconv.ParseInt: parsing \"1a\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapSDKListenerRuleByPriority(tt.args.sdkLRs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_listenerRuleSynthesizer_synthesizeListenerRulesOnListener(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resLR1 := elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+		ListenerARN: coremodel.LiteralStringToken("my-listener"),
+		Priority:    1,
+	})
+	resLR2 := elbv2model.NewListenerRule(stack, "80:2", elbv2model.ListenerRuleSpec{
+		ListenerARN: coremodel.LiteralStringToken("my-listener"),
+		Priority:    2,
+	})
+	resLR3 := elbv2model.NewListenerRule(stack, "80:3", elbv2model.ListenerRuleSpec{
+		ListenerARN: coremodel.LiteralStringToken("my-listener"),
+		Priority:    3,
+	})
+	sdkLR1 := ListenerRuleWithTags{
+		ListenerRule: &elbv2sdk.Rule{
+			RuleArn:  awssdk.String("arn-1"),
+			Priority: awssdk.String("1"),
+		},
+	}
+	sdkLR5 := ListenerRuleWithTags{
+		ListenerRule: &elbv2sdk.Rule{
+			RuleArn:  awssdk.String("arn-5"),
+			Priority: awssdk.String("5"),
+		},
+	}
+	tooManyRulesErr := errors.Wrap(awserr.New("TooManyRules", "some message", nil),
+		"failed to create listener rule for namespace/name with priority 2, listener my-listener has reached the maximum number of rules")
+
+	type createCall struct {
+		resLR  *elbv2model.ListenerRule
+		status elbv2model.ListenerRuleStatus
+		err    error
+	}
+	type updateCall struct {
+		resLR  *elbv2model.ListenerRule
+		sdkLR  ListenerRuleWithTags
+		status elbv2model.ListenerRuleStatus
+	}
+	type fields struct {
+		sdkLRs      []ListenerRuleWithTags
+		deleteCalls []ListenerRuleWithTags
+		createCalls []createCall
+		updateCalls []updateCall
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		resLRs  []*elbv2model.ListenerRule
+		wantErr error
+	}{
+		{
+			name: "all listener rules reconciled",
+			fields: fields{
+				sdkLRs:      []ListenerRuleWithTags{sdkLR1, sdkLR5},
+				deleteCalls: []ListenerRuleWithTags{sdkLR5},
+				createCalls: []createCall{
+					{
+						resLR:  resLR2,
+						status: elbv2model.ListenerRuleStatus{RuleARN: "arn-2"},
+					},
+					{
+						resLR:  resLR3,
+						status: elbv2model.ListenerRuleStatus{RuleARN: "arn-3"},
+					},
+				},
+				updateCalls: []updateCall{
+					{
+						resLR:  resLR1,
+						sdkLR:  sdkLR1,
+						status: elbv2model.ListenerRuleStatus{RuleARN: "arn-1"},
+					},
+				},
+			},
+			resLRs: []*elbv2model.ListenerRule{resLR1, resLR2, resLR3},
+		},
+		{
+			name: "remaining listener rules aren't created once listener is full",
+			fields: fields{
+				sdkLRs:      []ListenerRuleWithTags{sdkLR1, sdkLR5},
+				deleteCalls: []ListenerRuleWithTags{sdkLR5},
+				createCalls: []createCall{
+					{
+						resLR: resLR2,
+						err:   tooManyRulesErr,
+					},
+				},
+				updateCalls: []updateCall{
+					{
+						resLR:  resLR1,
+						sdkLR:  sdkLR1,
+						status: elbv2model.ListenerRuleStatus{RuleARN: "arn-1"},
+					},
+				},
+			},
+			resLRs:  []*elbv2model.ListenerRule{resLR1, resLR2, resLR3},
+			wantErr: errors.New("listener rule count: 1, listener rules not created: 2: failed to create listener rule for namespace/name with priority 2, listener my-listener has reached the maximum number of rules: TooManyRules: some message"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			taggingManager := NewMockTaggingManager(ctrl)
+			taggingManager.EXPECT().ListListenerRules(gomock.Any(), "my-listener").Return(tt.fields.sdkLRs, nil)
+			lrManager := NewMockListenerRuleManager(ctrl)
+			for _, sdkLR := range tt.fields.deleteCalls {
+				lrManager.EXPECT().Delete(gomock.Any(), sdkLR).Return(nil)
+			}
+			for _, call := range tt.fields.createCalls {
+				lrManager.EXPECT().Create(gomock.Any(), call.resLR).Return(call.status, call.err)
+			}
+			for _, call := range tt.fields.updateCalls {
+				lrManager.EXPECT().Update(gomock.Any(), call.resLR, call.sdkLR).Return(call.status, nil)
+			}
+			s := &listenerRuleSynthesizer{
+				lrManager:      lrManager,
+				logger:         &log.NullLogger{},
+				taggingManager: taggingManager,
+				stack:          stack,
+			}
+			err := s.synthesizeListenerRulesOnListener(context.Background(), "my-listener", tt.resLRs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -21,10 +21,10 @@ func buildSDKActions(modelActions []elbv2model.Action) ([]*elbv2sdk.Action, erro
 		sdkActions = make([]*elbv2sdk.Action, 0, len(modelActions))
 		for index, modelAction := range modelActions {
 			sdkAction, err := buildSDKAction(modelAction)
-			sdkAction.Order = awssdk.Int64(int64(index) + 1)
 			if err != nil {
 				return nil, err
 			}
+			sdkAction.Order = awssdk.Int64(int64(index) + 1)
 			sdkActions = append(sdkActions, sdkAction)
 		}
 	}
@@ -216,3 +216,21 @@ func isListenerNotFoundError(err error) bool {
 	}
 	return false
 }
+
+// isListenerRuleNotFoundError checks whether err is caused by a listener rule that doesn't exist.
+func isListenerRuleNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "RuleNotFound"
+	}
+	return false
+}
+
+// isTooManyListenerRulesError checks whether err is caused by exceeding the rules quota of a listener.
+func isTooManyListenerRulesError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "TooManyRules"
+	}
+	return false
+}
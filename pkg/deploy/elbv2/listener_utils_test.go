@@ -1,12 +1,115 @@
 package elbv2
 
 import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
 
+func Test_buildSDKActions(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	unfulfilledTG := elbv2model.NewTargetGroup(stack, "tg-1", elbv2model.TargetGroupSpec{})
+	type args struct {
+		modelActions []elbv2model.Action
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*elbv2sdk.Action
+		wantErr error
+	}{
+		{
+			name: "authenticate and forward actions are ordered by position",
+			args: args{
+				modelActions: []elbv2model.Action{
+					{
+						Type: elbv2model.ActionTypeAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2model.AuthenticateCognitoActionConfig{
+							UserPoolARN:      "pool-arn",
+							UserPoolClientID: "client-id",
+							UserPoolDomain:   "domain",
+						},
+					},
+					{
+						Type: elbv2model.ActionTypeForward,
+						ForwardConfig: &elbv2model.ForwardActionConfig{
+							TargetGroups: []elbv2model.TargetGroupTuple{
+								{
+									TargetGroupARN: core.LiteralStringToken("tg-arn"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []*elbv2sdk.Action{
+				{
+					Type: awssdk.String("authenticate-cognito"),
+					AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						AuthenticationRequestExtraParams: map[string]*string{},
+						UserPoolArn:                      awssdk.String("pool-arn"),
+						UserPoolClientId:                 awssdk.String("client-id"),
+						UserPoolDomain:                   awssdk.String("domain"),
+					},
+					Order: awssdk.Int64(1),
+				},
+				{
+					Type: awssdk.String("forward"),
+					ForwardConfig: &elbv2sdk.ForwardActionConfig{
+						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+							{
+								TargetGroupArn: awssdk.String("tg-arn"),
+							},
+						},
+					},
+					Order: awssdk.Int64(2),
+				},
+			},
+		},
+		{
+			name: "forward action with unfulfilled targetGroup",
+			args: args{
+				modelActions: []elbv2model.Action{
+					{
+						Type: elbv2model.ActionTypeForward,
+						ForwardConfig: &elbv2model.ForwardActionConfig{
+							TargetGroups: []elbv2model.TargetGroupTuple{
+								{
+									TargetGroupARN: unfulfilledTG.TargetGroupARN(),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroup is not fulfilled yet: tg-1"),
+		},
+		{
+			name: "no actions",
+			args: args{
+				modelActions: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSDKActions(tt.args.modelActions)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_isListenerNotFoundError(t *testing.T) {
 	type args struct {
 		err error
@@ -45,3 +148,81 @@ func Test_isListenerNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func Test_isListenerRuleNotFoundError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "is RuleNotFound error",
+			args: args{
+				err: awserr.New("RuleNotFound", "some message", nil),
+			},
+			want: true,
+		},
+		{
+			name: "wraps RuleNotFound error",
+			args: args{
+				err: errors.Wrap(awserr.New("RuleNotFound", "some message", nil), "wrapped message"),
+			},
+			want: true,
+		},
+		{
+			name: "isn't RuleNotFound error",
+			args: args{
+				err: errors.New("some other error"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isListenerRuleNotFoundError(tt.args.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isTooManyListenerRulesError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "is TooManyRules error",
+			args: args{
+				err: awserr.New("TooManyRules", "some message", nil),
+			},
+			want: true,
+		},
+		{
+			name: "wraps TooManyRules error",
+			args: args{
+				err: errors.Wrap(awserr.New("TooManyRules", "some message", nil), "wrapped message"),
+			},
+			want: true,
+		},
+		{
+			name: "isn't TooManyRules error",
+			args: args{
+				err: errors.New("some other error"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isTooManyListenerRulesError(tt.args.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
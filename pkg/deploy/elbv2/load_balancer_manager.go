@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -14,13 +15,38 @@ import (
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+const (
+	lbAttrsDeletionProtectionEnabled = "deletion_protection.enabled"
+)
+
 // LoadBalancerManager is responsible for create/update/delete LoadBalancer resources.
 type LoadBalancerManager interface {
 	Create(ctx context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error)
 
 	Update(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (elbv2model.LoadBalancerStatus, error)
 
-	Delete(ctx context.Context, sdkLB LoadBalancerWithTags) error
+	Delete(ctx context.Context, sdkLB LoadBalancerWithTags, opts ...LoadBalancerDeleteOption) error
+}
+
+// options for Delete API.
+type LoadBalancerDeleteOptions struct {
+	// OverrideDeletionProtection defines whether deletion protection should be disabled when it blocks the deletion.
+	OverrideDeletionProtection bool
+}
+
+func (opts *LoadBalancerDeleteOptions) ApplyOptions(options []LoadBalancerDeleteOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+type LoadBalancerDeleteOption func(opts *LoadBalancerDeleteOptions)
+
+// WithOverrideDeletionProtection is a delete option that disables deletion protection when it blocks the deletion.
+func WithOverrideDeletionProtection() LoadBalancerDeleteOption {
+	return func(opts *LoadBalancerDeleteOptions) {
+		opts.OverrideDeletionProtection = true
+	}
 }
 
 // NewDefaultLoadBalancerManager constructs new defaultLoadBalancerManager.
@@ -101,20 +127,51 @@ func (m *defaultLoadBalancerManager) Update(ctx context.Context, resLB *elbv2mod
 	return buildResLoadBalancerStatus(sdkLB), nil
 }
 
-func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalancerWithTags) error {
+func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalancerWithTags, opts ...LoadBalancerDeleteOption) error {
+	deleteOpts := LoadBalancerDeleteOptions{}
+	deleteOpts.ApplyOptions(opts)
 	req := &elbv2sdk.DeleteLoadBalancerInput{
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
 	}
 	m.logger.Info("deleting loadBalancer",
 		"arn", awssdk.StringValue(req.LoadBalancerArn))
 	if _, err := m.elbv2Client.DeleteLoadBalancerWithContext(ctx, req); err != nil {
-		return err
+		if !deleteOpts.OverrideDeletionProtection || !isOperationNotPermittedError(err) {
+			return err
+		}
+		// deletion protection blocks DeleteLoadBalancer, we disable it and retry the deletion.
+		if err := m.disableSDKLoadBalancerDeletionProtection(ctx, sdkLB); err != nil {
+			return err
+		}
+		if _, err := m.elbv2Client.DeleteLoadBalancerWithContext(ctx, req); err != nil {
+			return err
+		}
 	}
 	m.logger.Info("deleted loadBalancer",
 		"arn", awssdk.StringValue(req.LoadBalancerArn))
 	return nil
 }
 
+func (m *defaultLoadBalancerManager) disableSDKLoadBalancerDeletionProtection(ctx context.Context, sdkLB LoadBalancerWithTags) error {
+	req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		Attributes: []*elbv2sdk.LoadBalancerAttribute{
+			{
+				Key:   awssdk.String(lbAttrsDeletionProtectionEnabled),
+				Value: awssdk.String("false"),
+			},
+		},
+	}
+	m.logger.Info("disabling loadBalancer deletion protection",
+		"arn", awssdk.StringValue(req.LoadBalancerArn))
+	if _, err := m.elbv2Client.ModifyLoadBalancerAttributesWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to disable deletion protection")
+	}
+	m.logger.Info("disabled loadBalancer deletion protection",
+		"arn", awssdk.StringValue(req.LoadBalancerArn))
+	return nil
+}
+
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
 	if resLB.Spec.IPAddressType == nil {
 		return nil
@@ -227,6 +284,15 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.C
 		WithIgnoredTagKeys(m.externalManagedTags))
 }
 
+// isOperationNotPermittedError checks whether err is caused by an operation that isn't permitted, e.g. deleting a loadBalancer with deletion protection enabled.
+func isOperationNotPermittedError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == "OperationNotPermitted"
+	}
+	return false
+}
+
 func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
 	sdkObj := &elbv2sdk.CreateLoadBalancerInput{}
 	sdkObj.Name = awssdk.String(lbSpec.Name)
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
 
@@ -410,3 +414,149 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 		})
 	}
 }
+
+func Test_defaultLoadBalancerManager_Delete(t *testing.T) {
+	type deleteLoadBalancerWithContextCall struct {
+		req  *elbv2sdk.DeleteLoadBalancerInput
+		resp *elbv2sdk.DeleteLoadBalancerOutput
+		err  error
+	}
+	type modifyLoadBalancerAttributesWithContextCall struct {
+		req  *elbv2sdk.ModifyLoadBalancerAttributesInput
+		resp *elbv2sdk.ModifyLoadBalancerAttributesOutput
+		err  error
+	}
+	type fields struct {
+		deleteLoadBalancerWithContextCalls           []deleteLoadBalancerWithContextCall
+		modifyLoadBalancerAttributesWithContextCalls []modifyLoadBalancerAttributesWithContextCall
+	}
+	sdkLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2sdk.LoadBalancer{
+			LoadBalancerArn: awssdk.String("my-arn"),
+		},
+	}
+	disableDeletionProtectionReq := &elbv2sdk.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: awssdk.String("my-arn"),
+		Attributes: []*elbv2sdk.LoadBalancerAttribute{
+			{
+				Key:   awssdk.String("deletion_protection.enabled"),
+				Value: awssdk.String("false"),
+			},
+		},
+	}
+	type args struct {
+		opts []LoadBalancerDeleteOption
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "loadBalancer deleted",
+			fields: fields{
+				deleteLoadBalancerWithContextCalls: []deleteLoadBalancerWithContextCall{
+					{
+						req:  &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						resp: &elbv2sdk.DeleteLoadBalancerOutput{},
+					},
+				},
+			},
+		},
+		{
+			name: "protected loadBalancer isn't deleted without deletion protection override",
+			fields: fields{
+				deleteLoadBalancerWithContextCalls: []deleteLoadBalancerWithContextCall{
+					{
+						req: &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						err: awserr.New("OperationNotPermitted", "Load balancer 'my-arn' cannot be deleted because deletion protection is enabled", nil),
+					},
+				},
+			},
+			wantErr: errors.New("OperationNotPermitted: Load balancer 'my-arn' cannot be deleted because deletion protection is enabled"),
+		},
+		{
+			name: "loadBalancer deleted after disabling deletion protection",
+			fields: fields{
+				deleteLoadBalancerWithContextCalls: []deleteLoadBalancerWithContextCall{
+					{
+						req: &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						err: awserr.New("OperationNotPermitted", "Load balancer 'my-arn' cannot be deleted because deletion protection is enabled", nil),
+					},
+					{
+						req:  &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						resp: &elbv2sdk.DeleteLoadBalancerOutput{},
+					},
+				},
+				modifyLoadBalancerAttributesWithContextCalls: []modifyLoadBalancerAttributesWithContextCall{
+					{
+						req:  disableDeletionProtectionReq,
+						resp: &elbv2sdk.ModifyLoadBalancerAttributesOutput{},
+					},
+				},
+			},
+			args: args{
+				opts: []LoadBalancerDeleteOption{WithOverrideDeletionProtection()},
+			},
+		},
+		{
+			name: "failed to disable deletion protection",
+			fields: fields{
+				deleteLoadBalancerWithContextCalls: []deleteLoadBalancerWithContextCall{
+					{
+						req: &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						err: awserr.New("OperationNotPermitted", "Load balancer 'my-arn' cannot be deleted because deletion protection is enabled", nil),
+					},
+				},
+				modifyLoadBalancerAttributesWithContextCalls: []modifyLoadBalancerAttributesWithContextCall{
+					{
+						req: disableDeletionProtectionReq,
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				opts: []LoadBalancerDeleteOption{WithOverrideDeletionProtection()},
+			},
+			wantErr: errors.New("failed to disable deletion protection: some error"),
+		},
+		{
+			name: "failed to delete loadBalancer",
+			fields: fields{
+				deleteLoadBalancerWithContextCalls: []deleteLoadBalancerWithContextCall{
+					{
+						req: &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String("my-arn")},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			var deleteCalls []*gomock.Call
+			for _, call := range tt.fields.deleteLoadBalancerWithContextCalls {
+				deleteCalls = append(deleteCalls, elbv2Client.EXPECT().DeleteLoadBalancerWithContext(gomock.Any(), call.req).Return(call.resp, call.err))
+			}
+			gomock.InOrder(deleteCalls...)
+			for _, call := range tt.fields.modifyLoadBalancerAttributesWithContextCalls {
+				elbv2Client.EXPECT().ModifyLoadBalancerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultLoadBalancerManager{
+				elbv2Client: elbv2Client,
+				logger:      &log.NullLogger{},
+			}
+			err := m.Delete(context.Background(), sdkLB, tt.args.opts...)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -53,8 +53,13 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 	//  * LoadBalancer delete will automatically delete listeners attached to it.
 	//  * we can avoid the operation to detach a targetGroup from unmatched LBs. (a targetGroup can only attach to one LB).
 	// I don't like this, but it's the easiest solution to meet our requirement :D.
+	resLBIDs := sets.StringKeySet(mapResLoadBalancerByResourceID(resLBs))
 	for _, sdkLB := range unmatchedSDKLBs {
-		if err := s.lbManager.Delete(ctx, sdkLB); err != nil {
+		var deleteOpts []LoadBalancerDeleteOption
+		if isSDKLoadBalancerRemovedFromStack(sdkLB, resLBIDs, s.trackingProvider.ResourceIDTagKey()) {
+			deleteOpts = append(deleteOpts, WithOverrideDeletionProtection())
+		}
+		if err := s.lbManager.Delete(ctx, sdkLB, deleteOpts...); err != nil {
 			return err
 		}
 	}
@@ -167,3 +172,12 @@ func isSDKLoadBalancerRequiresReplacement(sdkLB LoadBalancerWithTags, resLB *elb
 	}
 	return false
 }
+
+// isSDKLoadBalancerRemovedFromStack checks whether a sdk ALB is deleted because it's removed from the stack(e.g. Ingresses are deleted).
+// deletion protection is only overridden for such ALBs, an ALB that requires replacement or a NLB still fails to be deleted when protected.
+func isSDKLoadBalancerRemovedFromStack(sdkLB LoadBalancerWithTags, resLBIDs sets.String, resourceIDTagKey string) bool {
+	if awssdk.StringValue(sdkLB.LoadBalancer.Type) != string(elbv2model.LoadBalancerTypeApplication) {
+		return false
+	}
+	return !resLBIDs.Has(sdkLB.Tags[resourceIDTagKey])
+}
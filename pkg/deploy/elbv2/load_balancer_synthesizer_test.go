@@ -5,6 +5,7 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -594,3 +595,75 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 		})
 	}
 }
+
+func Test_isSDKLoadBalancerRemovedFromStack(t *testing.T) {
+	type args struct {
+		sdkLB            LoadBalancerWithTags
+		resLBIDs         sets.String
+		resourceIDTagKey string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "ALB removed from stack",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("arn-1"),
+						Type:            awssdk.String("application"),
+					},
+					Tags: map[string]string{
+						"ingress.k8s.aws/resource": "LoadBalancer",
+					},
+				},
+				resLBIDs:         sets.NewString(),
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: true,
+		},
+		{
+			name: "ALB requires replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("arn-1"),
+						Type:            awssdk.String("application"),
+						Scheme:          awssdk.String("internal"),
+					},
+					Tags: map[string]string{
+						"ingress.k8s.aws/resource": "LoadBalancer",
+					},
+				},
+				resLBIDs:         sets.NewString("LoadBalancer"),
+				resourceIDTagKey: "ingress.k8s.aws/resource",
+			},
+			want: false,
+		},
+		{
+			name: "NLB removed from stack",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("arn-1"),
+						Type:            awssdk.String("network"),
+					},
+					Tags: map[string]string{
+						"service.k8s.aws/resource": "LoadBalancer",
+					},
+				},
+				resLBIDs:         sets.NewString(),
+				resourceIDTagKey: "service.k8s.aws/resource",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKLoadBalancerRemovedFromStack(tt.args.sdkLB, tt.args.resLBIDs, tt.args.resourceIDTagKey)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -55,6 +55,12 @@ func CompareOptionForRedirectActionConfig() cmp.Option {
 	})
 }
 
+// CompareOptionForAuthenticateOIDCActionConfig returns the compare option for authenticate-oidc action config.
+// ELBV2 never returns the client secret, so it's ignored during comparison.
+func CompareOptionForAuthenticateOIDCActionConfig() cmp.Option {
+	return cmpopts.IgnoreFields(elbv2sdk.AuthenticateOidcActionConfig{}, "ClientSecret")
+}
+
 // CompareOptionForAction returns the compare option for action.
 func CompareOptionForAction() cmp.Option {
 	return cmp.Options{
@@ -62,6 +68,7 @@ func CompareOptionForAction() cmp.Option {
 		cmpopts.IgnoreFields(elbv2sdk.Action{}, "TargetGroupArn"),
 		CompareOptionForForwardActionConfig(),
 		CompareOptionForRedirectActionConfig(),
+		CompareOptionForAuthenticateOIDCActionConfig(),
 	}
 }
 
@@ -410,6 +410,62 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 	}
 }
 
+func TestCompareOptionForAuthenticateOIDCActionConfig(t *testing.T) {
+	type args struct {
+		lhs *elbv2sdk.AuthenticateOidcActionConfig
+		rhs *elbv2sdk.AuthenticateOidcActionConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "equals irrelevant of clientSecret",
+			args: args{
+				lhs: &elbv2sdk.AuthenticateOidcActionConfig{
+					Issuer:                awssdk.String("https://example.com"),
+					AuthorizationEndpoint: awssdk.String("https://example.com/auth"),
+					TokenEndpoint:         awssdk.String("https://example.com/token"),
+					UserInfoEndpoint:      awssdk.String("https://example.com/userinfo"),
+					ClientId:              awssdk.String("client-id"),
+					ClientSecret:          awssdk.String("client-secret"),
+				},
+				rhs: &elbv2sdk.AuthenticateOidcActionConfig{
+					Issuer:                awssdk.String("https://example.com"),
+					AuthorizationEndpoint: awssdk.String("https://example.com/auth"),
+					TokenEndpoint:         awssdk.String("https://example.com/token"),
+					UserInfoEndpoint:      awssdk.String("https://example.com/userinfo"),
+					ClientId:              awssdk.String("client-id"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "clientId not equals",
+			args: args{
+				lhs: &elbv2sdk.AuthenticateOidcActionConfig{
+					Issuer:       awssdk.String("https://example.com"),
+					ClientId:     awssdk.String("client-id"),
+					ClientSecret: awssdk.String("client-secret"),
+				},
+				rhs: &elbv2sdk.AuthenticateOidcActionConfig{
+					Issuer:   awssdk.String("https://example.com"),
+					ClientId: awssdk.String("other-client-id"),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := CompareOptionForAuthenticateOIDCActionConfig()
+			got := cmp.Equal(tt.args.lhs, tt.args.rhs, opts)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestCompareOptionForAction(t *testing.T) {
 	type args struct {
 		lhs elbv2sdk.Action
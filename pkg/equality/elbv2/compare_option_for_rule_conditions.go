@@ -1,15 +1,34 @@
 package elbv2
 
 import (
+	"encoding/json"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"sort"
 )
 
+// CompareOptionForRuleConditionValues returns the compare option for values inside rule condition configs.
+// ELBV2 don't guarantee the order of values returned, so we compare them as sets.
+func CompareOptionForRuleConditionValues() cmp.Option {
+	return cmp.Options{
+		cmpopts.SortSlices(func(lhs *string, rhs *string) bool {
+			return awssdk.StringValue(lhs) < awssdk.StringValue(rhs)
+		}),
+		cmpopts.SortSlices(func(lhs *elbv2sdk.QueryStringKeyValuePair, rhs *elbv2sdk.QueryStringKeyValuePair) bool {
+			if awssdk.StringValue(lhs.Key) != awssdk.StringValue(rhs.Key) {
+				return awssdk.StringValue(lhs.Key) < awssdk.StringValue(rhs.Key)
+			}
+			return awssdk.StringValue(lhs.Value) < awssdk.StringValue(rhs.Value)
+		}),
+	}
+}
+
 func CompareOptionForRuleCondition() cmp.Option {
 	return cmp.Options{
 		cmpopts.IgnoreFields(elbv2sdk.RuleCondition{}, "Values"),
+		CompareOptionForRuleConditionValues(),
 	}
 }
 
@@ -18,8 +37,48 @@ func CompareOptionForRuleConditions() cmp.Option {
 	return cmp.Options{
 		cmpopts.EquateEmpty(),
 		cmpopts.SortSlices(func(lhs *elbv2sdk.RuleCondition, rhs *elbv2sdk.RuleCondition) bool {
-			return awssdk.StringValue(lhs.Field) < awssdk.StringValue(rhs.Field)
+			// there can be multiple http-header or query-string conditions, we sort them by the whole condition.
+			return canonicalKeyOfRuleCondition(lhs) < canonicalKeyOfRuleCondition(rhs)
 		}),
 		CompareOptionForRuleCondition(),
 	}
 }
+
+// canonicalKeyOfRuleCondition returns a key that identifies rule condition irrelevant of the order of values.
+func canonicalKeyOfRuleCondition(condition *elbv2sdk.RuleCondition) string {
+	parts := [][]string{{awssdk.StringValue(condition.Field)}}
+	if condition.HostHeaderConfig != nil {
+		parts = append(parts, sortedStringValues(condition.HostHeaderConfig.Values))
+	}
+	if condition.HttpHeaderConfig != nil {
+		parts = append(parts, []string{awssdk.StringValue(condition.HttpHeaderConfig.HttpHeaderName)},
+			sortedStringValues(condition.HttpHeaderConfig.Values))
+	}
+	if condition.HttpRequestMethodConfig != nil {
+		parts = append(parts, sortedStringValues(condition.HttpRequestMethodConfig.Values))
+	}
+	if condition.PathPatternConfig != nil {
+		parts = append(parts, sortedStringValues(condition.PathPatternConfig.Values))
+	}
+	if condition.QueryStringConfig != nil {
+		pairs := make([]string, 0, len(condition.QueryStringConfig.Values))
+		for _, pair := range condition.QueryStringConfig.Values {
+			pairKey, _ := json.Marshal([]string{awssdk.StringValue(pair.Key), awssdk.StringValue(pair.Value)})
+			pairs = append(pairs, string(pairKey))
+		}
+		sort.Strings(pairs)
+		parts = append(parts, pairs)
+	}
+	if condition.SourceIpConfig != nil {
+		parts = append(parts, sortedStringValues(condition.SourceIpConfig.Values))
+	}
+	key, _ := json.Marshal(parts)
+	return string(key)
+}
+
+// sortedStringValues returns the sorted values of string pointers.
+func sortedStringValues(values []*string) []string {
+	sortedValues := awssdk.StringValueSlice(values)
+	sort.Strings(sortedValues)
+	return sortedValues
+}
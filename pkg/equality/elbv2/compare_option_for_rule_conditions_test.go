@@ -0,0 +1,399 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCompareOptionForRuleCondition(t *testing.T) {
+	type args struct {
+		lhs elbv2sdk.RuleCondition
+		rhs elbv2sdk.RuleCondition
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "two host-header conditions equals exactly",
+			args: args{
+				lhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com", "app.example.com"}),
+					},
+				},
+				rhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com", "app.example.com"}),
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two host-header conditions equals irrelevant of values order",
+			args: args{
+				lhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com", "app.example.com"}),
+					},
+				},
+				rhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"app.example.com", "www.example.com"}),
+					},
+					Values: awssdk.StringSlice([]string{"app.example.com", "www.example.com"}),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two host-header conditions are not equals if values mismatch",
+			args: args{
+				lhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com", "app.example.com"}),
+					},
+				},
+				rhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com"}),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "two query-string conditions equals irrelevant of values order",
+			args: args{
+				lhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("query-string"),
+					QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+						Values: []*elbv2sdk.QueryStringKeyValuePair{
+							{
+								Key:   awssdk.String("version"),
+								Value: awssdk.String("v2"),
+							},
+							{
+								Value: awssdk.String("beta"),
+							},
+						},
+					},
+				},
+				rhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("query-string"),
+					QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+						Values: []*elbv2sdk.QueryStringKeyValuePair{
+							{
+								Value: awssdk.String("beta"),
+							},
+							{
+								Key:   awssdk.String("version"),
+								Value: awssdk.String("v2"),
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two query-string conditions are not equals if key mismatch",
+			args: args{
+				lhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("query-string"),
+					QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+						Values: []*elbv2sdk.QueryStringKeyValuePair{
+							{
+								Key:   awssdk.String("version"),
+								Value: awssdk.String("v2"),
+							},
+						},
+					},
+				},
+				rhs: elbv2sdk.RuleCondition{
+					Field: awssdk.String("query-string"),
+					QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+						Values: []*elbv2sdk.QueryStringKeyValuePair{
+							{
+								Value: awssdk.String("v2"),
+							},
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cmp.Equal(tt.args.lhs, tt.args.rhs, CompareOptionForRuleCondition())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareOptionForRuleConditions(t *testing.T) {
+	type args struct {
+		lhs []*elbv2sdk.RuleCondition
+		rhs []*elbv2sdk.RuleCondition
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "two rule conditions slice equals irrelevant of order",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("host-header"),
+						HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+							Values: awssdk.StringSlice([]string{"www.example.com"}),
+						},
+					},
+					{
+						Field: awssdk.String("path-pattern"),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+							Values: awssdk.StringSlice([]string{"/app", "/app/*"}),
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("path-pattern"),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+							Values: awssdk.StringSlice([]string{"/app/*", "/app"}),
+						},
+					},
+					{
+						Field: awssdk.String("host-header"),
+						HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+							Values: awssdk.StringSlice([]string{"www.example.com"}),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two rule conditions slice with multiple http-header conditions equals irrelevant of order",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("X-Canary"),
+							Values:         awssdk.StringSlice([]string{"true"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Mobile*"}),
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Mobile*"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("X-Canary"),
+							Values:         awssdk.StringSlice([]string{"true"}),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two rule conditions slice with multiple query-string conditions equals irrelevant of order",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("query-string"),
+						QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+							Values: []*elbv2sdk.QueryStringKeyValuePair{
+								{
+									Key:   awssdk.String("version"),
+									Value: awssdk.String("v2"),
+								},
+							},
+						},
+					},
+					{
+						Field: awssdk.String("query-string"),
+						QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+							Values: []*elbv2sdk.QueryStringKeyValuePair{
+								{
+									Key:   awssdk.String("lang"),
+									Value: awssdk.String("en"),
+								},
+								{
+									Value: awssdk.String("beta"),
+								},
+							},
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("query-string"),
+						QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+							Values: []*elbv2sdk.QueryStringKeyValuePair{
+								{
+									Value: awssdk.String("beta"),
+								},
+								{
+									Key:   awssdk.String("lang"),
+									Value: awssdk.String("en"),
+								},
+							},
+						},
+					},
+					{
+						Field: awssdk.String("query-string"),
+						QueryStringConfig: &elbv2sdk.QueryStringConditionConfig{
+							Values: []*elbv2sdk.QueryStringKeyValuePair{
+								{
+									Key:   awssdk.String("version"),
+									Value: awssdk.String("v2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two rule conditions slice with multiple same-name http-header conditions equals irrelevant of order",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Mobile*", "*Android*"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Chrome*"}),
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Chrome*"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Android*", "*Mobile*"}),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "two rule conditions slice with multiple same-name http-header conditions are not equals if values mismatch",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Mobile*"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Chrome*"}),
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Chrome*"}),
+						},
+					},
+					{
+						Field: awssdk.String("http-header"),
+						HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+							HttpHeaderName: awssdk.String("User-Agent"),
+							Values:         awssdk.StringSlice([]string{"*Firefox*"}),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "two rule conditions slice are not equals if condition missing",
+			args: args{
+				lhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("host-header"),
+						HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+							Values: awssdk.StringSlice([]string{"www.example.com"}),
+						},
+					},
+					{
+						Field: awssdk.String("path-pattern"),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+							Values: awssdk.StringSlice([]string{"/app"}),
+						},
+					},
+				},
+				rhs: []*elbv2sdk.RuleCondition{
+					{
+						Field: awssdk.String("path-pattern"),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+							Values: awssdk.StringSlice([]string{"/app"}),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cmp.Equal(tt.args.lhs, tt.args.rhs, CompareOptionForRuleConditions())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
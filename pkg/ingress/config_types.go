@@ -114,6 +114,9 @@ type ForwardActionConfig struct {
 }
 
 func (c *ForwardActionConfig) validate() error {
+	if len(c.TargetGroups) == 0 {
+		return errors.New("targetGroups cannot be empty")
+	}
 	for _, t := range c.TargetGroups {
 		if err := t.validate(); err != nil {
 			return errors.Wrap(err, "invalid TargetGroupTuple")
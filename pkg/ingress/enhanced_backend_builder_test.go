@@ -1178,6 +1178,36 @@ func Test_defaultEnhancedBackendBuilder_buildActionViaAnnotation(t *testing.T) {
 			},
 			wantErr: errors.New("missing actions.non-exists configuration"),
 		},
+		{
+			name: "forward action - missing targetGroups",
+			args: args{
+				ingAnnotation: map[string]string{
+					"alb.ingress.kubernetes.io/actions.forward": `{"type":"forward","forwardConfig":{"targetGroups":[]}}`,
+				},
+				svcName: "forward",
+			},
+			wantErr: errors.New("invalid ForwardConfig: targetGroups cannot be empty"),
+		},
+		{
+			name: "redirect action - missing statusCode",
+			args: args{
+				ingAnnotation: map[string]string{
+					"alb.ingress.kubernetes.io/actions.redirect": `{"type":"redirect","redirectConfig":{"protocol":"HTTPS","port":"443"}}`,
+				},
+				svcName: "redirect",
+			},
+			wantErr: errors.New("invalid RedirectConfig: statusCode is required"),
+		},
+		{
+			name: "fixed response action - missing statusCode",
+			args: args{
+				ingAnnotation: map[string]string{
+					"alb.ingress.kubernetes.io/actions.response-503": `{"type":"fixed-response","fixedResponseConfig":{"contentType":"text/plain"}}`,
+				},
+				svcName: "response-503",
+			},
+			wantErr: errors.New("invalid FixedResponseConfig: statusCode is required"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3,16 +3,34 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+const (
+	// maximum length for ELBv2's host-header and path-pattern condition values.
+	maxRuleConditionPatternLength = 128
+	// maximum number of condition values across all conditions of an ELBv2 rule.
+	maxRuleConditionValues = 5
+)
+
+var (
+	// ELBv2's path patterns can contain A–Z, a–z, 0–9, _ - . $ / ~ " ' @ : + & and wildcards * ?
+	pathPatternRegex = regexp.MustCompile(`^[A-Za-z0-9_\-.$/~"'@:+&*?]+$`)
+	// ELBv2's host-header patterns can contain A–Z, a–z, 0–9, - . and wildcards * ?
+	hostHeaderPatternRegex = regexp.MustCompile(`^[A-Za-z0-9\-.*?]+$`)
+	// ELBv2's host-header patterns can only contain alphabetical characters and wildcards after the final "."
+	hostHeaderPatternTLDRegex = regexp.MustCompile(`^[A-Za-z*?]+$`)
+)
+
 func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, protocol elbv2model.Protocol, ingList []ClassifiedIngress) error {
 	if t.sslRedirectConfig != nil && protocol == elbv2model.ProtocolHTTP {
 		return nil
@@ -125,6 +143,16 @@ func (t *defaultModelBuildTask) buildRuleConditions(ctx context.Context, rule ne
 			conditions = append(conditions, sourceIPCondition)
 		}
 	}
+	for _, host := range hosts {
+		if err := validateHostHeaderPattern(host); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range paths {
+		if err := validatePathPattern(path); err != nil {
+			return nil, err
+		}
+	}
 	if len(hosts) != 0 {
 		conditions = append(conditions, t.buildHostHeaderCondition(ctx, hosts))
 	}
@@ -134,6 +162,9 @@ func (t *defaultModelBuildTask) buildRuleConditions(ctx context.Context, rule ne
 	if len(conditions) == 0 {
 		conditions = append(conditions, t.buildPathPatternCondition(ctx, []string{"/*"}))
 	}
+	if err := validateRuleConditions(conditions); err != nil {
+		return nil, err
+	}
 	return conditions, nil
 }
 
@@ -186,6 +217,77 @@ func (t *defaultModelBuildTask) buildPathPatternsForPrefixPathType(path string)
 	return []string{normalizedPath, normalizedPath + "/*"}, nil
 }
 
+// validateRuleConditions validates rule conditions against ELBv2's limits on a single rule.
+// host-header, path-pattern, http-request-method and source-ip conditions can be specified at most once,
+// and the total number of condition values cannot exceed maxRuleConditionValues.
+func validateRuleConditions(conditions []elbv2model.RuleCondition) error {
+	uniqueFields := sets.NewString(string(elbv2model.RuleConditionFieldHostHeader), string(elbv2model.RuleConditionFieldPathPattern),
+		string(elbv2model.RuleConditionFieldHTTPRequestMethod), string(elbv2model.RuleConditionFieldSourceIP))
+	seenFields := sets.NewString()
+	valuesCount := 0
+	for _, condition := range conditions {
+		field := string(condition.Field)
+		if uniqueFields.Has(field) && seenFields.Has(field) {
+			return errors.Errorf("rule condition %v shouldn't be specified more than once", field)
+		}
+		seenFields.Insert(field)
+		valuesCount += ruleConditionValuesCount(condition)
+	}
+	if valuesCount > maxRuleConditionValues {
+		return errors.Errorf("rule conditions shouldn't exceed %v condition values: %v", maxRuleConditionValues, valuesCount)
+	}
+	return nil
+}
+
+// ruleConditionValuesCount returns the number of condition values of rule condition.
+func ruleConditionValuesCount(condition elbv2model.RuleCondition) int {
+	switch condition.Field {
+	case elbv2model.RuleConditionFieldHostHeader:
+		return len(condition.HostHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldPathPattern:
+		return len(condition.PathPatternConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPHeader:
+		return len(condition.HTTPHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPRequestMethod:
+		return len(condition.HTTPRequestMethodConfig.Values)
+	case elbv2model.RuleConditionFieldQueryString:
+		return len(condition.QueryStringConfig.Values)
+	case elbv2model.RuleConditionFieldSourceIP:
+		return len(condition.SourceIPConfig.Values)
+	}
+	return 0
+}
+
+// validatePathPattern validates path pattern against ELBv2's length and character restrictions.
+func validatePathPattern(pathPattern string) error {
+	if len(pathPattern) > maxRuleConditionPatternLength {
+		return errors.Errorf("path pattern shouldn't exceed %v characters: %v", maxRuleConditionPatternLength, pathPattern)
+	}
+	if !pathPatternRegex.MatchString(pathPattern) {
+		return errors.Errorf("path pattern contains invalid characters: %v", pathPattern)
+	}
+	return nil
+}
+
+// validateHostHeaderPattern validates host header pattern against ELBv2's length, character and format restrictions.
+// the host pattern must contain at least one "." and only alphabetical characters or wildcards after the final ".".
+func validateHostHeaderPattern(hostPattern string) error {
+	if len(hostPattern) > maxRuleConditionPatternLength {
+		return errors.Errorf("host pattern shouldn't exceed %v characters: %v", maxRuleConditionPatternLength, hostPattern)
+	}
+	if !hostHeaderPatternRegex.MatchString(hostPattern) {
+		return errors.Errorf("host pattern contains invalid characters: %v", hostPattern)
+	}
+	lastDotIdx := strings.LastIndex(hostPattern, ".")
+	if lastDotIdx < 0 {
+		return errors.Errorf("host pattern must contain at least one \".\": %v", hostPattern)
+	}
+	if !hostHeaderPatternTLDRegex.MatchString(hostPattern[lastDotIdx+1:]) {
+		return errors.Errorf("host pattern must contain only alphabetical characters after the final \".\": %v", hostPattern)
+	}
+	return nil
+}
+
 func (t *defaultModelBuildTask) buildHTTPHeaderCondition(_ context.Context, condition RuleCondition) (elbv2model.RuleCondition, error) {
 	if condition.HTTPHeaderConfig == nil {
 		return elbv2model.RuleCondition{}, errors.New("missing HTTPHeaderConfig")
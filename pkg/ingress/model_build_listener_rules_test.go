@@ -4,6 +4,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"strings"
 	"testing"
 )
 
@@ -330,3 +332,235 @@ func Test_defaultModelBuildTask_buildPathPatternsForPrefixPathType(t *testing.T)
 		})
 	}
 }
+
+func Test_validatePathPattern(t *testing.T) {
+	tests := []struct {
+		name        string
+		pathPattern string
+		wantErr     error
+	}{
+		{
+			name:        "plain path",
+			pathPattern: "/api/v1",
+		},
+		{
+			name:        "path with wildcards",
+			pathPattern: "/img/*.png?",
+		},
+		{
+			name:        "path with allowed symbols",
+			pathPattern: "/a_b-c.d$e~f\"g'h@i:j+k&l",
+		},
+		{
+			name:        "path with exactly 128 characters",
+			pathPattern: "/" + strings.Repeat("a", 127),
+		},
+		{
+			name:        "path exceeds 128 characters",
+			pathPattern: "/" + strings.Repeat("a", 128),
+			wantErr:     errors.Errorf("path pattern shouldn't exceed 128 characters: /%v", strings.Repeat("a", 128)),
+		},
+		{
+			name:        "path with regex",
+			pathPattern: "/api/(v1|v2)",
+			wantErr:     errors.New("path pattern contains invalid characters: /api/(v1|v2)"),
+		},
+		{
+			name:        "path with space",
+			pathPattern: "/my path",
+			wantErr:     errors.New("path pattern contains invalid characters: /my path"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePathPattern(tt.pathPattern)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateHostHeaderPattern(t *testing.T) {
+	tests := []struct {
+		name        string
+		hostPattern string
+		wantErr     error
+	}{
+		{
+			name:        "plain host",
+			hostPattern: "www.example.com",
+		},
+		{
+			name:        "host with wildcards",
+			hostPattern: "*.example.co?",
+		},
+		{
+			name:        "host exceeds 128 characters",
+			hostPattern: strings.Repeat("a", 125) + ".com",
+			wantErr:     errors.Errorf("host pattern shouldn't exceed 128 characters: %v.com", strings.Repeat("a", 125)),
+		},
+		{
+			name:        "host with port",
+			hostPattern: "www.example.com:8080",
+			wantErr:     errors.New("host pattern contains invalid characters: www.example.com:8080"),
+		},
+		{
+			name:        "host with underscore",
+			hostPattern: "my_app.example.com",
+			wantErr:     errors.New("host pattern contains invalid characters: my_app.example.com"),
+		},
+		{
+			name:        "host with leading wildcard label",
+			hostPattern: "*.example.com",
+		},
+		{
+			name:        "host with wildcard after the final dot",
+			hostPattern: "www.example.*",
+		},
+		{
+			name:        "host without dot",
+			hostPattern: "example",
+			wantErr:     errors.New(`host pattern must contain at least one ".": example`),
+		},
+		{
+			name:        "host with digit after the final dot",
+			hostPattern: "example.c0m",
+			wantErr:     errors.New(`host pattern must contain only alphabetical characters after the final ".": example.c0m`),
+		},
+		{
+			name:        "host ends with dot",
+			hostPattern: "www.example.",
+			wantErr:     errors.New(`host pattern must contain only alphabetical characters after the final ".": www.example.`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHostHeaderPattern(tt.hostPattern)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateRuleConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []elbv2model.RuleCondition
+		wantErr    error
+	}{
+		{
+			name: "host-header, path-pattern and http-header conditions",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"www.example.com"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/app", "/app/*"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "X-Canary",
+						Values:         []string{"true"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "User-Agent",
+						Values:         []string{"*Mobile*"},
+					},
+				},
+			},
+		},
+		{
+			name: "condition values exceeds limit",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"www.example.com", "app.example.com"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{
+							{
+								Value: "v1",
+							},
+							{
+								Value: "v2",
+							},
+						},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2model.SourceIPConditionConfig{
+						Values: []string{"192.168.0.0/16", "10.0.0.0/8"},
+					},
+				},
+			},
+			wantErr: errors.New("rule conditions shouldn't exceed 5 condition values: 6"),
+		},
+		{
+			name: "duplicate http-request-method conditions",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+						Values: []string{"GET"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+						Values: []string{"HEAD"},
+					},
+				},
+			},
+			wantErr: errors.New("rule condition http-request-method shouldn't be specified more than once"),
+		},
+		{
+			name: "duplicate source-ip conditions",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2model.SourceIPConditionConfig{
+						Values: []string{"192.168.0.0/16"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2model.SourceIPConditionConfig{
+						Values: []string{"10.0.0.0/8"},
+					},
+				},
+			},
+			wantErr: errors.New("rule condition source-ip shouldn't be specified more than once"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleConditions(tt.conditions)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
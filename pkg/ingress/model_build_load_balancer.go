@@ -24,8 +24,13 @@ import (
 
 const (
 	resourceIDLoadBalancer = "LoadBalancer"
+
+	lbAttrsHTTPDesyncMitigationMode = "routing.http.desync_mitigation_mode"
 )
 
+// supported values for ALB's routing.http.desync_mitigation_mode attribute.
+var lbAttrsHTTPDesyncMitigationModeValues = sets.NewString("monitor", "defensive", "strictest")
+
 func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
 	lbSpec, err := t.buildLoadBalancerSpec(ctx, listenPortConfigByPort)
 	if err != nil {
@@ -291,6 +296,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 			mergedAttributes[attrKey] = attrValue
 		}
 	}
+	if mode, exists := mergedAttributes[lbAttrsHTTPDesyncMitigationMode]; exists && !lbAttrsHTTPDesyncMitigationModeValues.Has(mode) {
+		return nil, errors.Errorf("invalid loadBalancerAttribute %v: %v, must be one of %v",
+			lbAttrsHTTPDesyncMitigationMode, mode, lbAttrsHTTPDesyncMitigationModeValues.List())
+	}
 	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(mergedAttributes))
 	for attrKey, attrValue := range mergedAttributes {
 		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
@@ -479,6 +479,136 @@ func Test_defaultModelBuildTask_buildLoadBalancerTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerAttributes(t *testing.T) {
+	type fields struct {
+		ingGroup Group
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []elbv2.LoadBalancerAttribute
+		wantErr error
+	}{
+		{
+			name: "no attributes annotation",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2.LoadBalancerAttribute{},
+		},
+		{
+			name: "attributes annotation merged across members",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.desync_mitigation_mode=strictest",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=600",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2.LoadBalancerAttribute{
+				{
+					Key:   "routing.http.desync_mitigation_mode",
+					Value: "strictest",
+				},
+				{
+					Key:   "idle_timeout.timeout_seconds",
+					Value: "600",
+				},
+			},
+		},
+		{
+			name: "conflicting attributes",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.desync_mitigation_mode=monitor",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.desync_mitigation_mode=defensive",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting loadBalancerAttribute routing.http.desync_mitigation_mode: monitor | defensive"),
+		},
+		{
+			name: "invalid desync mitigation mode",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.desync_mitigation_mode=strict",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid loadBalancerAttribute routing.http.desync_mitigation_mode: strict, must be one of [defensive monitor strictest]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.fields.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildLoadBalancerAttributes(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 	type fields struct {
 		ingGroup Group
@@ -39,6 +39,8 @@ var _ RuleOptimizer = &defaultRuleOptimizer{}
 //   * It will omit any redirect rules that would result in a infinite redirect loop.
 //   * it will omit any rules that take priority by a redirect rule with a super set of conditions
 //  	(ideally this could applies to other action type as well, but we only consider redirect action for now)
+//   * it will warn about any remaining rules that are overshadowed by a higher priority rule with a super set of conditions,
+//  	since such rules will never be matched.
 type defaultRuleOptimizer struct {
 	logger logr.Logger
 }
@@ -46,6 +48,7 @@ type defaultRuleOptimizer struct {
 func (o *defaultRuleOptimizer) Optimize(_ context.Context, port int64, protocol elbv2model.Protocol, rules []Rule) ([]Rule, error) {
 	optimizedRules := o.omitInfiniteRedirectRules(port, protocol, rules)
 	optimizedRules = o.omitOvershadowedRulesAfterRedirectRules(optimizedRules)
+	o.warnOvershadowedRules(port, optimizedRules)
 	return optimizedRules, nil
 }
 
@@ -83,6 +86,31 @@ func (o *defaultRuleOptimizer) omitOvershadowedRulesAfterRedirectRules(rules []R
 	return optimizedRules
 }
 
+// warnOvershadowedRules logs rules that will never be matched because a higher priority rule have a super set of conditions.
+func (o *defaultRuleOptimizer) warnOvershadowedRules(port int64, rules []Rule) {
+	for idx := range rules {
+		overshadowingIdx := findOvershadowingRuleIndex(rules, idx)
+		if overshadowingIdx < 0 {
+			continue
+		}
+		o.logger.Info("listener rule is overshadowed by higher priority rule and will never be matched",
+			"port", port,
+			"priority", idx+1,
+			"overshadowedByPriority", overshadowingIdx+1)
+	}
+}
+
+// findOvershadowingRuleIndex finds the index of first rule before rules[idx] that have a super set of conditions.
+// returns -1 if rules[idx] isn't overshadowed.
+func findOvershadowingRuleIndex(rules []Rule, idx int) int {
+	for i := 0; i < idx; i++ {
+		if isSupersetConditions(rules[i].Conditions, rules[idx].Conditions) {
+			return i
+		}
+	}
+	return -1
+}
+
 // isInfiniteRedirectRule checks whether specified rule will cause a infinite redirect loop.
 func isInfiniteRedirectRule(port int64, protocol elbv2model.Protocol, rule Rule) bool {
 	redirectActionCFG := findRedirectActionConfig(rule.Actions)
@@ -229,6 +229,89 @@ func Test_defaultRuleOptimizer_Optimize(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "overshadowed rules that are not after a redirect rule should be kept in order",
+			args: args{
+				port:     80,
+				protocol: elbv2model.ProtocolHTTP,
+				rules: []Rule{
+					{
+						Conditions: []elbv2model.RuleCondition{
+							{
+								Field: elbv2model.RuleConditionFieldPathPattern,
+								PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+									Values: []string{"/*"},
+								},
+							},
+						},
+						Actions: []elbv2model.Action{
+							{
+								Type: elbv2model.ActionTypeFixedResponse,
+								FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+									StatusCode: "200",
+								},
+							},
+						},
+					},
+					{
+						Conditions: []elbv2model.RuleCondition{
+							{
+								Field: elbv2model.RuleConditionFieldPathPattern,
+								PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+									Values: []string{"/app"},
+								},
+							},
+						},
+						Actions: []elbv2model.Action{
+							{
+								Type: elbv2model.ActionTypeFixedResponse,
+								FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+									StatusCode: "404",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []Rule{
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/*"},
+							},
+						},
+					},
+					Actions: []elbv2model.Action{
+						{
+							Type: elbv2model.ActionTypeFixedResponse,
+							FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+								StatusCode: "200",
+							},
+						},
+					},
+				},
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/app"},
+							},
+						},
+					},
+					Actions: []elbv2model.Action{
+						{
+							Type: elbv2model.ActionTypeFixedResponse,
+							FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+								StatusCode: "404",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -907,6 +990,93 @@ func Test_isSupersetConditions(t *testing.T) {
 	}
 }
 
+func Test_findOvershadowingRuleIndex(t *testing.T) {
+	hostOnlyRule := Rule{
+		Conditions: []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldHostHeader,
+				HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+					Values: []string{"www.example.com"},
+				},
+			},
+		},
+	}
+	hostAndPathRule := Rule{
+		Conditions: []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldHostHeader,
+				HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+					Values: []string{"www.example.com"},
+				},
+			},
+			{
+				Field: elbv2model.RuleConditionFieldPathPattern,
+				PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+					Values: []string{"/app"},
+				},
+			},
+		},
+	}
+	otherHostRule := Rule{
+		Conditions: []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldHostHeader,
+				HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+					Values: []string{"app.example.com"},
+				},
+			},
+		},
+	}
+	type args struct {
+		rules []Rule
+		idx   int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "less-specific rule precedes more-specific rule",
+			args: args{
+				rules: []Rule{hostOnlyRule, hostAndPathRule},
+				idx:   1,
+			},
+			want: 0,
+		},
+		{
+			name: "more-specific rule precedes less-specific rule",
+			args: args{
+				rules: []Rule{hostAndPathRule, hostOnlyRule},
+				idx:   1,
+			},
+			want: -1,
+		},
+		{
+			name: "rules with unrelated conditions",
+			args: args{
+				rules: []Rule{hostOnlyRule, otherHostRule},
+				idx:   1,
+			},
+			want: -1,
+		},
+		{
+			name: "first rule can never be overshadowed",
+			args: args{
+				rules: []Rule{hostOnlyRule, hostAndPathRule},
+				idx:   0,
+			},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findOvershadowingRuleIndex(tt.args.rules, tt.args.idx)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_findRedirectActionConfig(t *testing.T) {
 	type args struct {
 		actions []elbv2model.Action